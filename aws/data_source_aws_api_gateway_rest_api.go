@@ -0,0 +1,138 @@
+package aws
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsApiGatewayRestApi() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsApiGatewayRestApiRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"policy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"binary_media_types": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"minimum_compression_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"root_resource_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"execution_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"endpoint_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"types": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"vpc_endpoint_ids": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsApiGatewayRestApiRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigateway
+	name := d.Get("name").(string)
+
+	var restApis []*apigateway.RestApi
+	input := &apigateway.GetRestApisInput{}
+	err := conn.GetRestApisPages(input, func(page *apigateway.GetRestApisOutput, lastPage bool) bool {
+		for _, api := range page.Items {
+			if aws.StringValue(api.Name) == name {
+				restApis = append(restApis, api)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return fmt.Errorf("error describing API Gateway REST APIs: %s", err)
+	}
+
+	if len(restApis) == 0 {
+		return fmt.Errorf("no REST APIs with name %q found", name)
+	}
+	if len(restApis) > 1 {
+		return fmt.Errorf("multiple REST APIs with name %q found", name)
+	}
+
+	api := restApis[0]
+	d.SetId(aws.StringValue(api.Id))
+	d.Set("description", api.Description)
+	d.Set("binary_media_types", api.BinaryMediaTypes)
+
+	if api.MinimumCompressionSize == nil {
+		d.Set("minimum_compression_size", -1)
+	} else {
+		d.Set("minimum_compression_size", api.MinimumCompressionSize)
+	}
+
+	if api.Policy != nil {
+		policy, err := strconv.Unquote(`"` + aws.StringValue(api.Policy) + `"`)
+		if err != nil {
+			return fmt.Errorf("error unescaping policy: %s", err)
+		}
+		d.Set("policy", policy)
+	}
+
+	executionArn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "execute-api",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  d.Id(),
+	}.String()
+	d.Set("execution_arn", executionArn)
+
+	if err := d.Set("endpoint_configuration", flattenApiGatewayEndpointConfiguration(api.EndpointConfiguration)); err != nil {
+		return fmt.Errorf("error setting endpoint_configuration: %s", err)
+	}
+
+	if err := resourceAwsApiGatewayRestApiRefreshResources(d, meta); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSDataSourceApiGatewayRestApi_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_api_gateway_rest_api.test"
+	dataSourceName := "data.aws_api_gateway_rest_api.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAPIGatewayRestAPIDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSourceApiGatewayRestApiConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "root_resource_id", resourceName, "root_resource_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "execution_arn", resourceName, "execution_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "binary_media_types.#", resourceName, "binary_media_types.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "minimum_compression_size", resourceName, "minimum_compression_size"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "endpoint_configuration.#", resourceName, "endpoint_configuration.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDataSourceApiGatewayRestApi_notFound(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSDataSourceApiGatewayRestApiConfigNotFound(rName),
+				ExpectError: regexp.MustCompile(`no REST APIs with name`),
+			},
+		},
+	})
+}
+
+func TestAccAWSDataSourceApiGatewayRestApi_multipleFound(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAPIGatewayRestAPIDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSDataSourceApiGatewayRestApiConfigMultipleFound(rName),
+				ExpectError: regexp.MustCompile(`multiple REST APIs with name`),
+			},
+		},
+	})
+}
+
+func testAccAWSDataSourceApiGatewayRestApiConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_api_gateway_rest_api" "test" {
+  name        = %[1]q
+  description = "test"
+}
+
+data "aws_api_gateway_rest_api" "test" {
+  name = aws_api_gateway_rest_api.test.name
+}
+`, rName)
+}
+
+func testAccAWSDataSourceApiGatewayRestApiConfigNotFound(rName string) string {
+	return fmt.Sprintf(`
+data "aws_api_gateway_rest_api" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSDataSourceApiGatewayRestApiConfigMultipleFound(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_api_gateway_rest_api" "test1" {
+  name = %[1]q
+}
+
+resource "aws_api_gateway_rest_api" "test2" {
+  name = %[1]q
+}
+
+data "aws_api_gateway_rest_api" "test" {
+  name = %[1]q
+
+  depends_on = [aws_api_gateway_rest_api.test1, aws_api_gateway_rest_api.test2]
+}
+`, rName)
+}
@@ -52,6 +52,22 @@ func resourceAwsApiGatewayRestApi() *schema.Resource {
 				Optional: true,
 			},
 
+			"put_rest_api_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  apigateway.PutModeOverwrite,
+				ValidateFunc: validation.StringInSlice([]string{
+					apigateway.PutModeMerge,
+					apigateway.PutModeOverwrite,
+				}, false),
+			},
+
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
 			"minimum_compression_size": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -91,12 +107,37 @@ func resourceAwsApiGatewayRestApi() *schema.Resource {
 								ValidateFunc: validation.StringInSlice([]string{
 									apigateway.EndpointTypeEdge,
 									apigateway.EndpointTypeRegional,
+									apigateway.EndpointTypePrivate,
 								}, false),
 							},
 						},
+						"vpc_endpoint_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
 					},
 				},
 			},
+
+			"tags": tagsSchema(),
+
+			"api_key_source": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					apigateway.ApiKeySourceTypeHeader,
+					apigateway.ApiKeySourceTypeAuthorizer,
+				}, false),
+			},
+
+			"disable_execute_api_endpoint": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -119,6 +160,14 @@ func resourceAwsApiGatewayRestApiCreate(d *schema.ResourceData, meta interface{}
 		params.EndpointConfiguration = expandApiGatewayEndpointConfiguration(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("api_key_source"); ok {
+		params.ApiKeySource = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOkExists("disable_execute_api_endpoint"); ok {
+		params.DisableExecuteApiEndpoint = aws.Bool(v.(bool))
+	}
+
 	if v, ok := d.GetOk("policy"); ok && v.(string) != "" {
 		params.Policy = aws.String(v.(string))
 	}
@@ -140,13 +189,30 @@ func resourceAwsApiGatewayRestApiCreate(d *schema.ResourceData, meta interface{}
 
 	d.SetId(*gateway.Id)
 
+	if v, ok := d.GetOk("tags"); ok && len(v.(map[string]interface{})) > 0 {
+		_, err := conn.TagResource(&apigateway.TagResourceInput{
+			ResourceArn: aws.String(resourceAwsApiGatewayRestApiArn(meta, d.Id())),
+			Tags:        stringMapToPointers(v.(map[string]interface{})),
+		})
+		if err != nil {
+			return fmt.Errorf("error tagging API Gateway (%s): %s", d.Id(), err)
+		}
+	}
+
 	if body, ok := d.GetOk("body"); ok {
 		log.Printf("[DEBUG] Initializing API Gateway from OpenAPI spec %s", d.Id())
-		_, err := conn.PutRestApi(&apigateway.PutRestApiInput{
+
+		putInput := &apigateway.PutRestApiInput{
 			RestApiId: gateway.Id,
-			Mode:      aws.String(apigateway.PutModeOverwrite),
+			Mode:      aws.String(d.Get("put_rest_api_mode").(string)),
 			Body:      []byte(body.(string)),
-		})
+		}
+
+		if v, ok := d.GetOk("parameters"); ok {
+			putInput.Parameters = stringMapToPointers(v.(map[string]interface{}))
+		}
+
+		_, err := conn.PutRestApi(putInput)
 		if err != nil {
 			return errwrap.Wrapf("Error creating API Gateway specification: {{err}}", err)
 		}
@@ -159,6 +225,15 @@ func resourceAwsApiGatewayRestApiCreate(d *schema.ResourceData, meta interface{}
 	return resourceAwsApiGatewayRestApiRead(d, meta)
 }
 
+func resourceAwsApiGatewayRestApiArn(meta interface{}, restApiId string) string {
+	return arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "apigateway",
+		Region:    meta.(*AWSClient).region,
+		Resource:  fmt.Sprintf("/restapis/%s", restApiId),
+	}.String()
+}
+
 func resourceAwsApiGatewayRestApiRefreshResources(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).apigateway
 
@@ -230,6 +305,19 @@ func resourceAwsApiGatewayRestApiRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("error setting endpoint_configuration: %s", err)
 	}
 
+	d.Set("api_key_source", api.ApiKeySource)
+	d.Set("disable_execute_api_endpoint", api.DisableExecuteApiEndpoint)
+
+	getTagsResp, err := conn.GetTags(&apigateway.GetTagsInput{
+		ResourceArn: aws.String(resourceAwsApiGatewayRestApiArn(meta, d.Id())),
+	})
+	if err != nil {
+		return fmt.Errorf("error getting API Gateway (%s) tags: %s", d.Id(), err)
+	}
+	if err := d.Set("tags", pointersMapToStringList(getTagsResp.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
 	return nil
 }
 
@@ -314,6 +402,46 @@ func resourceAwsApiGatewayRestApiUpdateOperations(d *schema.ResourceData) []*api
 		}
 	}
 
+	if d.HasChange("api_key_source") {
+		operations = append(operations, &apigateway.PatchOperation{
+			Op:    aws.String("replace"),
+			Path:  aws.String("/apiKeySource"),
+			Value: aws.String(d.Get("api_key_source").(string)),
+		})
+	}
+
+	if d.HasChange("disable_execute_api_endpoint") {
+		operations = append(operations, &apigateway.PatchOperation{
+			Op:    aws.String("replace"),
+			Path:  aws.String("/disableExecuteApiEndpoint"),
+			Value: aws.String(strconv.FormatBool(d.Get("disable_execute_api_endpoint").(bool))),
+		})
+	}
+
+	if d.HasChange("endpoint_configuration.0.vpc_endpoint_ids") {
+		o, n := d.GetChange("endpoint_configuration.0.vpc_endpoint_ids")
+		prefix := "/endpointConfiguration/vpcEndpointIds"
+
+		old := o.(*schema.Set)
+		new := n.(*schema.Set)
+
+		for _, v := range old.Difference(new).List() {
+			operations = append(operations, &apigateway.PatchOperation{
+				Op:    aws.String("remove"),
+				Path:  aws.String(prefix),
+				Value: aws.String(v.(string)),
+			})
+		}
+
+		for _, v := range new.Difference(old).List() {
+			operations = append(operations, &apigateway.PatchOperation{
+				Op:    aws.String("add"),
+				Path:  aws.String(prefix),
+				Value: aws.String(v.(string)),
+			})
+		}
+	}
+
 	return operations
 }
 
@@ -321,14 +449,21 @@ func resourceAwsApiGatewayRestApiUpdate(d *schema.ResourceData, meta interface{}
 	conn := meta.(*AWSClient).apigateway
 	log.Printf("[DEBUG] Updating API Gateway %s", d.Id())
 
-	if d.HasChange("body") {
+	if d.HasChange("body") || d.HasChange("put_rest_api_mode") || d.HasChange("parameters") {
 		if body, ok := d.GetOk("body"); ok {
 			log.Printf("[DEBUG] Updating API Gateway from OpenAPI spec: %s", d.Id())
-			_, err := conn.PutRestApi(&apigateway.PutRestApiInput{
+
+			putInput := &apigateway.PutRestApiInput{
 				RestApiId: aws.String(d.Id()),
-				Mode:      aws.String(apigateway.PutModeOverwrite),
+				Mode:      aws.String(d.Get("put_rest_api_mode").(string)),
 				Body:      []byte(body.(string)),
-			})
+			}
+
+			if v, ok := d.GetOk("parameters"); ok {
+				putInput.Parameters = stringMapToPointers(v.(map[string]interface{}))
+			}
+
+			_, err := conn.PutRestApi(putInput)
 			if err != nil {
 				return errwrap.Wrapf("Error updating API Gateway specification: {{err}}", err)
 			}
@@ -345,9 +480,54 @@ func resourceAwsApiGatewayRestApiUpdate(d *schema.ResourceData, meta interface{}
 	}
 	log.Printf("[DEBUG] Updated API Gateway %s", d.Id())
 
+	if d.HasChange("tags") {
+		restApiArn := resourceAwsApiGatewayRestApiArn(meta, d.Id())
+		o, n := d.GetChange("tags")
+
+		if err := resourceAwsApiGatewayRestApiUpdateTags(conn, restApiArn, o.(map[string]interface{}), n.(map[string]interface{})); err != nil {
+			return fmt.Errorf("error updating API Gateway (%s) tags: %s", d.Id(), err)
+		}
+	}
+
 	return resourceAwsApiGatewayRestApiRead(d, meta)
 }
 
+func resourceAwsApiGatewayRestApiUpdateTags(conn *apigateway.APIGateway, resourceArn string, oldTags, newTags map[string]interface{}) error {
+	removedTags := make([]*string, 0)
+	for k := range oldTags {
+		if _, ok := newTags[k]; !ok {
+			removedTags = append(removedTags, aws.String(k))
+		}
+	}
+	if len(removedTags) > 0 {
+		_, err := conn.UntagResource(&apigateway.UntagResourceInput{
+			ResourceArn: aws.String(resourceArn),
+			TagKeys:     removedTags,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	addedTags := make(map[string]interface{})
+	for k, v := range newTags {
+		if old, ok := oldTags[k]; !ok || old != v {
+			addedTags[k] = v
+		}
+	}
+	if len(addedTags) > 0 {
+		_, err := conn.TagResource(&apigateway.TagResourceInput{
+			ResourceArn: aws.String(resourceArn),
+			Tags:        stringMapToPointers(addedTags),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func resourceAwsApiGatewayRestApiDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).apigateway
 	log.Printf("[DEBUG] Deleting API Gateway: %s", d.Id())
@@ -379,6 +559,10 @@ func expandApiGatewayEndpointConfiguration(l []interface{}) *apigateway.Endpoint
 		Types: expandStringList(m["types"].([]interface{})),
 	}
 
+	if v, ok := m["vpc_endpoint_ids"]; ok && v.(*schema.Set).Len() > 0 {
+		endpointConfiguration.VpcEndpointIds = expandStringSet(v.(*schema.Set))
+	}
+
 	return endpointConfiguration
 }
 
@@ -388,7 +572,8 @@ func flattenApiGatewayEndpointConfiguration(endpointConfiguration *apigateway.En
 	}
 
 	m := map[string]interface{}{
-		"types": flattenStringList(endpointConfiguration.Types),
+		"types":            flattenStringList(endpointConfiguration.Types),
+		"vpc_endpoint_ids": flattenStringSet(endpointConfiguration.VpcEndpointIds),
 	}
 
 	return []interface{}{m}
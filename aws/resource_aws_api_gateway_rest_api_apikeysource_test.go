@@ -0,0 +1,117 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSAPIGatewayRestApi_apiKeySource(t *testing.T) {
+	var restApi apigateway.RestApi
+	resourceName := "aws_api_gateway_rest_api.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAPIGatewayRestAPIDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAPIGatewayRestAPIConfigApiKeySource(rName, apigateway.ApiKeySourceTypeHeader),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayRestAPIExists(resourceName, &restApi),
+					resource.TestCheckResourceAttr(resourceName, "api_key_source", apigateway.ApiKeySourceTypeHeader),
+				),
+			},
+			{
+				Config:   testAccAWSAPIGatewayRestAPIConfigApiKeySource(rName, apigateway.ApiKeySourceTypeHeader),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccAWSAPIGatewayRestAPIConfigApiKeySource(rName, apigateway.ApiKeySourceTypeAuthorizer),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayRestAPIExists(resourceName, &restApi),
+					resource.TestCheckResourceAttr(resourceName, "api_key_source", apigateway.ApiKeySourceTypeAuthorizer),
+				),
+			},
+			{
+				Config:   testAccAWSAPIGatewayRestAPIConfigApiKeySource(rName, apigateway.ApiKeySourceTypeAuthorizer),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAPIGatewayRestApi_disableExecuteApiEndpoint(t *testing.T) {
+	var restApi apigateway.RestApi
+	resourceName := "aws_api_gateway_rest_api.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAPIGatewayRestAPIDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAPIGatewayRestAPIConfigName(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayRestAPIExists(resourceName, &restApi),
+					resource.TestCheckResourceAttr(resourceName, "disable_execute_api_endpoint", "false"),
+				),
+			},
+			{
+				// With the flag left out of the configuration, the value read back
+				// from the API must not produce a diff.
+				Config:   testAccAWSAPIGatewayRestAPIConfigName(rName),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccAWSAPIGatewayRestAPIConfigDisableExecuteApiEndpoint(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayRestAPIExists(resourceName, &restApi),
+					resource.TestCheckResourceAttr(resourceName, "disable_execute_api_endpoint", "true"),
+				),
+			},
+			{
+				Config:   testAccAWSAPIGatewayRestAPIConfigDisableExecuteApiEndpoint(rName, true),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccAWSAPIGatewayRestAPIConfigDisableExecuteApiEndpoint(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayRestAPIExists(resourceName, &restApi),
+					resource.TestCheckResourceAttr(resourceName, "disable_execute_api_endpoint", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSAPIGatewayRestAPIConfigName(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_api_gateway_rest_api" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSAPIGatewayRestAPIConfigApiKeySource(rName, apiKeySource string) string {
+	return fmt.Sprintf(`
+resource "aws_api_gateway_rest_api" "test" {
+  name           = %[1]q
+  api_key_source = %[2]q
+}
+`, rName, apiKeySource)
+}
+
+func testAccAWSAPIGatewayRestAPIConfigDisableExecuteApiEndpoint(rName string, disableExecuteApiEndpoint bool) string {
+	return fmt.Sprintf(`
+resource "aws_api_gateway_rest_api" "test" {
+  name                         = %[1]q
+  disable_execute_api_endpoint = %[2]t
+}
+`, rName, disableExecuteApiEndpoint)
+}
@@ -0,0 +1,79 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSAPIGatewayRestApi_putRestApiMode(t *testing.T) {
+	var restApi apigateway.RestApi
+	resourceName := "aws_api_gateway_rest_api.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAPIGatewayRestAPIDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAPIGatewayRestAPIConfigPutRestApiMode(rName, apigateway.PutModeOverwrite, "REGIONAL"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayRestAPIExists(resourceName, &restApi),
+					resource.TestCheckResourceAttr(resourceName, "put_rest_api_mode", apigateway.PutModeOverwrite),
+					resource.TestCheckResourceAttr(resourceName, "parameters.endpointConfigurationTypes", "REGIONAL"),
+				),
+			},
+			{
+				Config: testAccAWSAPIGatewayRestAPIConfigPutRestApiMode(rName, apigateway.PutModeMerge, "REGIONAL"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayRestAPIExists(resourceName, &restApi),
+					resource.TestCheckResourceAttr(resourceName, "put_rest_api_mode", apigateway.PutModeMerge),
+				),
+			},
+			{
+				Config: testAccAWSAPIGatewayRestAPIConfigPutRestApiMode(rName, apigateway.PutModeMerge, "PRIVATE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayRestAPIExists(resourceName, &restApi),
+					resource.TestCheckResourceAttr(resourceName, "parameters.endpointConfigurationTypes", "PRIVATE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSAPIGatewayRestAPIConfigPutRestApiMode(rName, mode, endpointConfigurationType string) string {
+	return fmt.Sprintf(`
+resource "aws_api_gateway_rest_api" "test" {
+  name = %[1]q
+
+  body = jsonencode({
+    swagger = "2.0"
+    info = {
+      title   = %[1]q
+      version = "1.0"
+    }
+    paths = {
+      "/test" = {
+        get = {
+          responses = {
+            "200" = {
+              description = "OK"
+            }
+          }
+        }
+      }
+    }
+  })
+
+  put_rest_api_mode = %[2]q
+
+  parameters = {
+    endpointConfigurationTypes = %[3]q
+  }
+}
+`, rName, mode, endpointConfigurationType)
+}